@@ -0,0 +1,73 @@
+package app
+
+import (
+	"net/http"
+	"os"
+	"sync/atomic"
+
+	"github.com/gorilla/handlers"
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/padok-team/training-containers/02-web-backend/pkg/store"
+)
+
+// App wires together the HTTP router and the Store backend used by the
+// handlers. NewApp is the single entry point both main and the test
+// suite use to stand the service up, so there is exactly one place
+// that knows how the pieces fit together.
+type App struct {
+	Router  *mux.Router
+	Handler http.Handler
+	Store   store.Store
+
+	ready int32
+}
+
+// NewApp builds the router and the storage backend selected by
+// cfg.Store from cfg. Tests call this directly against an ephemeral
+// backend container instead of going through main, so all wiring must
+// happen here rather than in func main.
+func NewApp(cfg Config) (*App, error) {
+	s, err := store.New(cfg.Store)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &App{Store: s}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/items/{key}", a.getItem).Methods(http.MethodGet)
+	router.HandleFunc("/items/{key}", a.setItem).Methods(http.MethodPut)
+	router.HandleFunc("/items/{key}", a.deleteItem).Methods(http.MethodDelete)
+	router.HandleFunc("/items", a.listItems).Methods(http.MethodGet)
+	router.HandleFunc("/healthz", a.healthz).Methods(http.MethodGet)
+	router.HandleFunc("/readyz", a.readyz).Methods(http.MethodGet)
+	router.Handle("/metrics", promhttp.Handler())
+
+	a.Router = router
+	a.Handler = otelhttp.NewHandler(handlers.LoggingHandler(os.Stdout, router), "web-backend")
+
+	return a, nil
+}
+
+// SetReady flips the flag the /readyz handler reports. main clears it
+// before draining connections so orchestrators stop routing new traffic
+// ahead of the shutdown grace period.
+func (a *App) SetReady(ready bool) {
+	var v int32
+	if ready {
+		v = 1
+	}
+	atomic.StoreInt32(&a.ready, v)
+}
+
+func (a *App) isReady() bool {
+	return atomic.LoadInt32(&a.ready) == 1
+}
+
+// Close releases the resources held by the App, namely the Store backend.
+func (a *App) Close() error {
+	return a.Store.Close()
+}
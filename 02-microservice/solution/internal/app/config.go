@@ -0,0 +1,65 @@
+package app
+
+import (
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/padok-team/training-containers/02-web-backend/pkg/store"
+)
+
+// Config holds the runtime configuration for the application. It is
+// populated from environment variables and CLI flags so the same
+// container image can be reconfigured without a rebuild.
+type Config struct {
+	ListenAddr    string
+	ShutdownGrace time.Duration
+	Store         store.Config
+}
+
+// ConfigFromEnv builds a Config from environment variables and the
+// process's command-line flags, falling back to sane defaults for local
+// development. The storage backend is selected via --backend, or the
+// STORAGE_BACKEND env var if the flag isn't set.
+func ConfigFromEnv() Config {
+	backend := flag.String("backend", envOrDefault("STORAGE_BACKEND", string(store.BackendRedis)), "storage backend: redis, postgres or mongo")
+	flag.Parse()
+
+	return Config{
+		ListenAddr:    envOrDefault("LISTEN_ADDR", ":8080"),
+		ShutdownGrace: durationOrDefault("SHUTDOWN_GRACE_PERIOD", 10*time.Second),
+		Store: store.Config{
+			Backend: store.Backend(*backend),
+
+			RedisAddr: envOrDefault("REDIS_ADDR", "localhost:6379"),
+			RedisDB:   0,
+
+			PostgresDSN: envOrDefault("POSTGRES_DSN", "postgres://localhost:5432/webbackend"),
+
+			MongoURI: envOrDefault("MONGO_URI", "mongodb://localhost:27017"),
+			MongoDB:  envOrDefault("MONGO_DB", "webbackend"),
+		},
+	}
+}
+
+func envOrDefault(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func durationOrDefault(key string, fallback time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		log.Printf("invalid %s=%q, using default %s: %v", key, v, fallback, err)
+		return fallback
+	}
+	return d
+}
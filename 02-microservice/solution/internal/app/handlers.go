@@ -0,0 +1,101 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/padok-team/training-containers/02-web-backend/pkg/store"
+)
+
+// requestTimeout bounds how long a handler waits on the storage backend
+// before giving up and reporting it as unavailable.
+const requestTimeout = 5 * time.Second
+
+type item struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// getItem handles GET /items/{key}. It returns 404 if the key is not
+// present in the store and 503 if the store itself cannot be reached.
+func (a *App) getItem(w http.ResponseWriter, r *http.Request) {
+	key := mux.Vars(r)["key"]
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+
+	val, err := a.Store.Get(ctx, key)
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		http.Error(w, "key not found", http.StatusNotFound)
+		return
+	case err != nil:
+		http.Error(w, "storage unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	writeJSON(w, item{Key: key, Value: val})
+}
+
+// setItem handles PUT /items/{key}, storing the JSON body's "value"
+// field under key.
+func (a *App) setItem(w http.ResponseWriter, r *http.Request) {
+	key := mux.Vars(r)["key"]
+
+	var payload struct {
+		Value string `json:"value"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+
+	if err := a.Store.Set(ctx, key, payload.Value); err != nil {
+		http.Error(w, "storage unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteItem handles DELETE /items/{key}.
+func (a *App) deleteItem(w http.ResponseWriter, r *http.Request) {
+	key := mux.Vars(r)["key"]
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+
+	if err := a.Store.Delete(ctx, key); err != nil {
+		http.Error(w, "storage unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listItems handles GET /items, returning every key currently stored.
+func (a *App) listItems(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+
+	keys, err := a.Store.List(ctx)
+	if err != nil {
+		http.Error(w, "storage unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	writeJSON(w, keys)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
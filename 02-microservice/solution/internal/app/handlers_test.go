@@ -0,0 +1,72 @@
+package app_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/padok-team/training-containers/02-web-backend/internal/testutil"
+)
+
+func TestItemsRoutes(t *testing.T) {
+	ra := testutil.StartRedis(t)
+	handler := ra.App.Handler
+
+	seed := func(t *testing.T) {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPut, "/items/foo", bytes.NewReader([]byte(`{"value":"bar"}`)))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("seed: expected 204, got %d", rec.Code)
+		}
+	}
+	seed(t)
+
+	cases := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{"get existing key", http.MethodGet, "/items/foo", "", http.StatusOK},
+		{"get missing key", http.MethodGet, "/items/missing", "", http.StatusNotFound},
+		{"set key", http.MethodPut, "/items/baz", `{"value":"qux"}`, http.StatusNoContent},
+		{"set key invalid body", http.MethodPut, "/items/baz", `not-json`, http.StatusBadRequest},
+		{"delete key", http.MethodDelete, "/items/foo", "", http.StatusNoContent},
+		{"list keys", http.MethodGet, "/items", "", http.StatusOK},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var body *bytes.Reader
+			if tc.body != "" {
+				body = bytes.NewReader([]byte(tc.body))
+			} else {
+				body = bytes.NewReader(nil)
+			}
+
+			req := httptest.NewRequest(tc.method, tc.path, body)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("%s %s: expected %d, got %d", tc.method, tc.path, tc.wantStatus, rec.Code)
+			}
+		})
+	}
+
+	t.Run("redis down", func(t *testing.T) {
+		ra.Stop(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/items/foo", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusServiceUnavailable {
+			t.Fatalf("expected 503 once redis is down, got %d", rec.Code)
+		}
+	})
+}
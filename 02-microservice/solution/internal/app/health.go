@@ -0,0 +1,33 @@
+package app
+
+import (
+	"context"
+	"net/http"
+)
+
+// healthz is the liveness probe: once the process is up it always
+// reports healthy, independent of the storage backend's state.
+func (a *App) healthz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// readyz is the readiness probe. It fails fast while the app is
+// shutting down so orchestrators stop routing new traffic immediately,
+// and otherwise reports ready only if the storage backend answers a
+// ping.
+func (a *App) readyz(w http.ResponseWriter, r *http.Request) {
+	if !a.isReady() {
+		http.Error(w, "shutting down", http.StatusServiceUnavailable)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+
+	if err := a.Store.Ping(ctx); err != nil {
+		http.Error(w, "storage unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,54 @@
+package app_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/padok-team/training-containers/02-web-backend/internal/testutil"
+)
+
+func TestHealthRoutes(t *testing.T) {
+	ra := testutil.StartRedis(t)
+	handler := ra.App.Handler
+
+	t.Run("healthz is always up", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d", rec.Code)
+		}
+	})
+
+	t.Run("readyz is up while serving", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusServiceUnavailable {
+			t.Fatalf("expected 503 before SetReady, got %d", rec.Code)
+		}
+
+		ra.App.SetReady(true)
+
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200 once ready and redis is up, got %d", rec.Code)
+		}
+	})
+
+	t.Run("readyz fails once redis is down", func(t *testing.T) {
+		ra.Stop(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusServiceUnavailable {
+			t.Fatalf("expected 503 once redis is down, got %d", rec.Code)
+		}
+	})
+}
@@ -0,0 +1,45 @@
+// Package telemetry configures OpenTelemetry tracing for the service.
+// It is a thin wrapper that gives main.go one place that knows how to
+// build and tear down the tracer provider.
+package telemetry
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+// Init configures a global TracerProvider that exports spans to the
+// OTLP endpoint named by OTEL_EXPORTER_OTLP_ENDPOINT. If that env var is
+// unset, tracing is left as the package-default no-op provider, so the
+// service behaves exactly as before OpenTelemetry was wired in. The
+// returned shutdown func flushes and closes the exporter; call it
+// before the process exits.
+func Init(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(ctx, resource.WithAttributes(semconv.ServiceNameKey.String(serviceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
@@ -0,0 +1,35 @@
+//go:build compose
+
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/compose"
+)
+
+// StartStack brings up the full docker-compose.yml stack (app, Redis and
+// the Prometheus scraper) via testcontainers' compose module, so
+// contributors can exercise the whole thing with `go test -tags compose
+// ./...` instead of running `docker compose` by hand. It is torn down via
+// t.Cleanup.
+func StartStack(t *testing.T) compose.ComposeStack {
+	t.Helper()
+
+	ctx := context.Background()
+	stack, err := compose.NewDockerCompose("../../docker-compose.yml")
+	if err != nil {
+		t.Fatalf("build compose stack: %v", err)
+	}
+
+	if err := stack.Up(ctx, compose.Wait(true)); err != nil {
+		t.Fatalf("start compose stack: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = stack.Down(context.Background())
+	})
+
+	return stack
+}
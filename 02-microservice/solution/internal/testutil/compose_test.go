@@ -0,0 +1,46 @@
+//go:build compose
+
+package testutil_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/padok-team/training-containers/02-web-backend/internal/testutil"
+)
+
+// TestStack smoke-tests the docker-compose stack end to end: app, Redis
+// and the Prometheus scraper all booting together. Gated behind the
+// "compose" build tag since it is much slower than the default test
+// suite.
+func TestStack(t *testing.T) {
+	ctx := context.Background()
+	stack := testutil.StartStack(t)
+
+	container, err := stack.ServiceContainer(ctx, "app")
+	if err != nil {
+		t.Fatalf("get app container: %v", err)
+	}
+
+	port, err := container.MappedPort(ctx, "8080")
+	if err != nil {
+		t.Fatalf("get app port: %v", err)
+	}
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		t.Fatalf("get app host: %v", err)
+	}
+
+	resp, err := http.Get(fmt.Sprintf("http://%s:%s/items", host, port.Port()))
+	if err != nil {
+		t.Fatalf("request /items: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from /items, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,95 @@
+// Package testutil provides helpers for exercising the application
+// against real, ephemeral dependencies started with testcontainers-go
+// instead of mocks.
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/padok-team/training-containers/02-web-backend/internal/app"
+	"github.com/padok-team/training-containers/02-web-backend/pkg/store"
+)
+
+// RedisApp bundles the running Redis container with a raw redis client
+// (for assertions that bypass the HTTP API) and the App built against
+// it, so a single call gives a test everything it needs to exercise the
+// HTTP handlers against a real Redis instance.
+type RedisApp struct {
+	Container testcontainers.Container
+	Client    *redis.Client
+	App       *app.App
+}
+
+// StartRedis launches an ephemeral Redis container, waits for it to
+// accept connections, and returns an App built via the same NewApp
+// constructor main.go uses, configured with the redis backend. The
+// container and the app's store are torn down automatically via
+// t.Cleanup; call Stop to bring Redis down earlier, to simulate an
+// outage mid-test.
+func StartRedis(t *testing.T) *RedisApp {
+	t.Helper()
+
+	ctx := context.Background()
+	req := testcontainers.ContainerRequest{
+		Image:        "redis:6-alpine",
+		ExposedPorts: []string{"6379/tcp"},
+		WaitingFor:   wait.ForLog("Ready to accept connections"),
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		t.Fatalf("start redis container: %v", err)
+	}
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		t.Fatalf("get redis host: %v", err)
+	}
+	port, err := container.MappedPort(ctx, "6379")
+	if err != nil {
+		t.Fatalf("get redis port: %v", err)
+	}
+	addr := fmt.Sprintf("%s:%s", host, port.Port())
+
+	a, err := app.NewApp(app.Config{
+		ListenAddr: ":0",
+		Store: store.Config{
+			Backend:   store.BackendRedis,
+			RedisAddr: addr,
+		},
+	})
+	if err != nil {
+		t.Fatalf("build app: %v", err)
+	}
+
+	ra := &RedisApp{
+		Container: container,
+		Client:    redis.NewClient(&redis.Options{Addr: addr}),
+		App:       a,
+	}
+	t.Cleanup(func() {
+		a.Close()
+		ra.Client.Close()
+		_ = container.Terminate(ctx)
+	})
+
+	return ra
+}
+
+// Stop stops the underlying Redis container early, simulating a Redis
+// outage without waiting for t.Cleanup, which still terminates it.
+func (ra *RedisApp) Stop(t *testing.T) {
+	t.Helper()
+	if err := ra.Container.Stop(context.Background(), nil); err != nil {
+		t.Fatalf("stop redis container: %v", err)
+	}
+}
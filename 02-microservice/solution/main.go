@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/padok-team/training-containers/02-web-backend/internal/app"
+	"github.com/padok-team/training-containers/02-web-backend/internal/telemetry"
+)
+
+func main() {
+	cfg := app.ConfigFromEnv()
+	ctx := context.Background()
+
+	shutdownTelemetry, err := telemetry.Init(ctx, "web-backend")
+	if err != nil {
+		log.Fatalf("failed to initialize telemetry: %v", err)
+	}
+	defer shutdownTelemetry(ctx)
+
+	a, err := app.NewApp(cfg)
+	if err != nil {
+		log.Fatalf("failed to initialize app: %v", err)
+	}
+	defer a.Close()
+
+	server := &http.Server{
+		Addr:    cfg.ListenAddr,
+		Handler: a.Handler,
+	}
+
+	go func() {
+		log.Printf("listening on %s", cfg.ListenAddr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("server error: %v", err)
+		}
+	}()
+	a.SetReady(true)
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	<-sigCh
+
+	log.Println("shutdown signal received, draining in-flight requests")
+	a.SetReady(false)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownGrace)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("graceful shutdown did not complete cleanly: %v", err)
+	}
+}
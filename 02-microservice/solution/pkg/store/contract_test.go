@@ -0,0 +1,62 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/padok-team/training-containers/02-web-backend/pkg/store"
+)
+
+// testStoreContract exercises the behaviour every Store implementation
+// must satisfy, regardless of backend. Each backend's test starts its
+// own container and then hands its Store here.
+func testStoreContract(t *testing.T, s store.Store) {
+	t.Helper()
+	ctx := context.Background()
+
+	if err := s.Ping(ctx); err != nil {
+		t.Fatalf("ping: %v", err)
+	}
+
+	if _, err := s.Get(ctx, "missing"); !errors.Is(err, store.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	if err := s.Set(ctx, "foo", "bar"); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+
+	val, err := s.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if val != "bar" {
+		t.Fatalf("expected %q, got %q", "bar", val)
+	}
+
+	keys, err := s.List(ctx)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if !containsKey(keys, "foo") {
+		t.Fatalf("expected %q in %v", "foo", keys)
+	}
+
+	if err := s.Delete(ctx, "foo"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	if _, err := s.Get(ctx, "foo"); !errors.Is(err, store.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+func containsKey(keys []string, target string) bool {
+	for _, k := range keys {
+		if k == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package store
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	opLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "storage_backend_operation_duration_seconds",
+		Help: "Latency of storage backend operations, by backend and operation.",
+	}, []string{"storage_backend", "operation"})
+
+	opErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "storage_backend_operation_errors_total",
+		Help: "Number of storage backend operations that returned an unexpected error.",
+	}, []string{"storage_backend", "operation"})
+)
+
+func init() {
+	prometheus.MustRegister(opLatency, opErrors)
+}
+
+// observe records the latency of a single backend operation and, unless
+// the error is the expected ErrNotFound, counts it as a failure.
+func observe(backend, operation string, start time.Time, err error) {
+	opLatency.WithLabelValues(backend, operation).Observe(time.Since(start).Seconds())
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		opErrors.WithLabelValues(backend, operation).Inc()
+	}
+}
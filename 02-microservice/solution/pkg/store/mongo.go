@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const mongoBackend = "mongo"
+
+type mongoDoc struct {
+	Key   string `bson:"_id"`
+	Value string `bson:"value"`
+}
+
+// MongoStore implements Store on top of a single MongoDB collection,
+// using each item's key as the document's _id.
+type MongoStore struct {
+	client     *mongo.Client
+	collection *mongo.Collection
+}
+
+// NewMongoStore connects to uri and targets the "items" collection in
+// database dbName.
+func NewMongoStore(uri, dbName string) (*MongoStore, error) {
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
+	if err != nil {
+		return nil, err
+	}
+
+	return &MongoStore{
+		client:     client,
+		collection: client.Database(dbName).Collection("items"),
+	}, nil
+}
+
+func (s *MongoStore) Get(ctx context.Context, key string) (val string, err error) {
+	defer func(start time.Time) { observe(mongoBackend, "get", start, err) }(time.Now())
+
+	var doc mongoDoc
+	err = s.collection.FindOne(ctx, bson.M{"_id": key}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", ErrNotFound
+	}
+	return doc.Value, err
+}
+
+func (s *MongoStore) Set(ctx context.Context, key, value string) (err error) {
+	defer func(start time.Time) { observe(mongoBackend, "set", start, err) }(time.Now())
+
+	opts := options.Replace().SetUpsert(true)
+	_, err = s.collection.ReplaceOne(ctx, bson.M{"_id": key}, mongoDoc{Key: key, Value: value}, opts)
+	return err
+}
+
+func (s *MongoStore) Delete(ctx context.Context, key string) (err error) {
+	defer func(start time.Time) { observe(mongoBackend, "delete", start, err) }(time.Now())
+
+	_, err = s.collection.DeleteOne(ctx, bson.M{"_id": key})
+	return err
+}
+
+func (s *MongoStore) List(ctx context.Context) (keys []string, err error) {
+	defer func(start time.Time) { observe(mongoBackend, "list", start, err) }(time.Now())
+
+	cur, err := s.collection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var doc mongoDoc
+		if err := cur.Decode(&doc); err != nil {
+			return nil, err
+		}
+		keys = append(keys, doc.Key)
+	}
+	return keys, cur.Err()
+}
+
+func (s *MongoStore) Ping(ctx context.Context) (err error) {
+	defer func(start time.Time) { observe(mongoBackend, "ping", start, err) }(time.Now())
+
+	return s.client.Ping(ctx, nil)
+}
+
+func (s *MongoStore) Close() error {
+	return s.client.Disconnect(context.Background())
+}
@@ -0,0 +1,48 @@
+package store_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tc "github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/padok-team/training-containers/02-web-backend/pkg/store"
+)
+
+func TestMongoStore(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := tc.GenericContainer(ctx, tc.GenericContainerRequest{
+		ContainerRequest: tc.ContainerRequest{
+			Image:        "mongo:5",
+			ExposedPorts: []string{"27017/tcp"},
+			WaitingFor:   wait.ForLog("Waiting for connections"),
+		},
+		Started: true,
+	})
+	if err != nil {
+		t.Fatalf("start mongo container: %v", err)
+	}
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		t.Fatalf("get host: %v", err)
+	}
+	port, err := container.MappedPort(ctx, "27017")
+	if err != nil {
+		t.Fatalf("get port: %v", err)
+	}
+
+	uri := fmt.Sprintf("mongodb://%s:%s", host, port.Port())
+
+	s, err := store.NewMongoStore(uri, "webbackend")
+	if err != nil {
+		t.Fatalf("new mongo store: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	testStoreContract(t, s)
+}
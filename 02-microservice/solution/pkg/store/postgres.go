@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const postgresBackend = "postgres"
+
+// PostgresStore implements Store on top of a single Postgres table,
+// created on first connect:
+//
+//	items(key TEXT PRIMARY KEY, value TEXT NOT NULL)
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore connects to dsn and ensures the items table exists.
+func NewPostgresStore(dsn string) (*PostgresStore, error) {
+	pool, err := pgxpool.Connect(context.Background(), dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := pool.Exec(context.Background(), `
+		CREATE TABLE IF NOT EXISTS items (
+			key   TEXT PRIMARY KEY,
+			value TEXT NOT NULL
+		)`); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return &PostgresStore{pool: pool}, nil
+}
+
+func (s *PostgresStore) Get(ctx context.Context, key string) (val string, err error) {
+	defer func(start time.Time) { observe(postgresBackend, "get", start, err) }(time.Now())
+
+	err = s.pool.QueryRow(ctx, `SELECT value FROM items WHERE key = $1`, key).Scan(&val)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	return val, err
+}
+
+func (s *PostgresStore) Set(ctx context.Context, key, value string) (err error) {
+	defer func(start time.Time) { observe(postgresBackend, "set", start, err) }(time.Now())
+
+	_, err = s.pool.Exec(ctx, `
+		INSERT INTO items (key, value) VALUES ($1, $2)
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value`, key, value)
+	return err
+}
+
+func (s *PostgresStore) Delete(ctx context.Context, key string) (err error) {
+	defer func(start time.Time) { observe(postgresBackend, "delete", start, err) }(time.Now())
+
+	_, err = s.pool.Exec(ctx, `DELETE FROM items WHERE key = $1`, key)
+	return err
+}
+
+func (s *PostgresStore) List(ctx context.Context) (keys []string, err error) {
+	defer func(start time.Time) { observe(postgresBackend, "list", start, err) }(time.Now())
+
+	rows, err := s.pool.Query(ctx, `SELECT key FROM items`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (s *PostgresStore) Ping(ctx context.Context) (err error) {
+	defer func(start time.Time) { observe(postgresBackend, "ping", start, err) }(time.Now())
+
+	return s.pool.Ping(ctx)
+}
+
+func (s *PostgresStore) Close() error {
+	s.pool.Close()
+	return nil
+}
@@ -0,0 +1,53 @@
+package store_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tc "github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/padok-team/training-containers/02-web-backend/pkg/store"
+)
+
+func TestPostgresStore(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := tc.GenericContainer(ctx, tc.GenericContainerRequest{
+		ContainerRequest: tc.ContainerRequest{
+			Image:        "postgres:13-alpine",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "postgres",
+				"POSTGRES_PASSWORD": "postgres",
+				"POSTGRES_DB":       "webbackend",
+			},
+			WaitingFor: wait.ForLog("database system is ready to accept connections"),
+		},
+		Started: true,
+	})
+	if err != nil {
+		t.Fatalf("start postgres container: %v", err)
+	}
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		t.Fatalf("get host: %v", err)
+	}
+	port, err := container.MappedPort(ctx, "5432")
+	if err != nil {
+		t.Fatalf("get port: %v", err)
+	}
+
+	dsn := fmt.Sprintf("postgres://postgres:postgres@%s:%s/webbackend?sslmode=disable", host, port.Port())
+
+	s, err := store.NewPostgresStore(dsn)
+	if err != nil {
+		t.Fatalf("new postgres store: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	testStoreContract(t, s)
+}
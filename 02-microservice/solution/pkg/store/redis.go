@@ -0,0 +1,65 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/extra/redisotel/v8"
+	"github.com/go-redis/redis/v8"
+)
+
+const redisBackend = "redis"
+
+// RedisStore implements Store on top of go-redis.
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore builds a RedisStore connected to addr/db. The connection
+// is established lazily by the underlying client on first use. The
+// client is instrumented with redisotel, so commands are traced
+// whenever an OpenTelemetry tracer provider has been configured.
+func NewRedisStore(addr string, db int) *RedisStore {
+	client := redis.NewClient(&redis.Options{Addr: addr, DB: db})
+	client.AddHook(redisotel.NewTracingHook())
+	return &RedisStore{client: client}
+}
+
+func (s *RedisStore) Get(ctx context.Context, key string) (val string, err error) {
+	defer func(start time.Time) { observe(redisBackend, "get", start, err) }(time.Now())
+
+	val, err = s.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ErrNotFound
+	}
+	return val, err
+}
+
+func (s *RedisStore) Set(ctx context.Context, key, value string) (err error) {
+	defer func(start time.Time) { observe(redisBackend, "set", start, err) }(time.Now())
+
+	return s.client.Set(ctx, key, value, 0).Err()
+}
+
+func (s *RedisStore) Delete(ctx context.Context, key string) (err error) {
+	defer func(start time.Time) { observe(redisBackend, "delete", start, err) }(time.Now())
+
+	return s.client.Del(ctx, key).Err()
+}
+
+func (s *RedisStore) List(ctx context.Context) (keys []string, err error) {
+	defer func(start time.Time) { observe(redisBackend, "list", start, err) }(time.Now())
+
+	return s.client.Keys(ctx, "*").Result()
+}
+
+func (s *RedisStore) Ping(ctx context.Context) (err error) {
+	defer func(start time.Time) { observe(redisBackend, "ping", start, err) }(time.Now())
+
+	return s.client.Ping(ctx).Err()
+}
+
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
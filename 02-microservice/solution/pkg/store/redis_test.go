@@ -0,0 +1,42 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	tc "github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/padok-team/training-containers/02-web-backend/pkg/store"
+)
+
+func TestRedisStore(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := tc.GenericContainer(ctx, tc.GenericContainerRequest{
+		ContainerRequest: tc.ContainerRequest{
+			Image:        "redis:6-alpine",
+			ExposedPorts: []string{"6379/tcp"},
+			WaitingFor:   wait.ForLog("Ready to accept connections"),
+		},
+		Started: true,
+	})
+	if err != nil {
+		t.Fatalf("start redis container: %v", err)
+	}
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		t.Fatalf("get host: %v", err)
+	}
+	port, err := container.MappedPort(ctx, "6379")
+	if err != nil {
+		t.Fatalf("get port: %v", err)
+	}
+
+	s := store.NewRedisStore(host+":"+port.Port(), 0)
+	t.Cleanup(func() { s.Close() })
+
+	testStoreContract(t, s)
+}
@@ -0,0 +1,63 @@
+// Package store abstracts the key/value storage backend behind the HTTP
+// API so the same handlers can run against Redis, Postgres or MongoDB.
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned by Get when the requested key does not exist,
+// regardless of which backend is in use.
+var ErrNotFound = errors.New("store: key not found")
+
+// Store is the storage abstraction every HTTP handler talks to. Each
+// backend implements it the same way so the rest of the application
+// never needs to know which one is in use.
+type Store interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key, value string) error
+	Delete(ctx context.Context, key string) error
+	List(ctx context.Context) ([]string, error)
+	Ping(ctx context.Context) error
+	Close() error
+}
+
+// Backend identifies which concrete Store implementation to use.
+type Backend string
+
+const (
+	BackendRedis    Backend = "redis"
+	BackendPostgres Backend = "postgres"
+	BackendMongo    Backend = "mongo"
+)
+
+// Config holds the connection settings for every backend. Only the
+// fields relevant to the selected Backend are used.
+type Config struct {
+	Backend Backend
+
+	RedisAddr string
+	RedisDB   int
+
+	PostgresDSN string
+
+	MongoURI string
+	MongoDB  string
+}
+
+// New builds the Store selected by cfg.Backend, defaulting to Redis when
+// Backend is left empty.
+func New(cfg Config) (Store, error) {
+	switch cfg.Backend {
+	case BackendRedis, "":
+		return NewRedisStore(cfg.RedisAddr, cfg.RedisDB), nil
+	case BackendPostgres:
+		return NewPostgresStore(cfg.PostgresDSN)
+	case BackendMongo:
+		return NewMongoStore(cfg.MongoURI, cfg.MongoDB)
+	default:
+		return nil, fmt.Errorf("store: unknown backend %q", cfg.Backend)
+	}
+}